@@ -11,6 +11,7 @@ import (
 	"log"
 	"net/http"
 	"path"
+	"sort"
 	"strings"
 	"time"
 
@@ -44,18 +45,32 @@ func (s *Server) handlePackageDetails(w http.ResponseWriter, r *http.Request) {
 		s.serveErrorPage(w, r, http.StatusBadRequest, nil)
 		return
 	}
+	ctx := r.Context()
 	if version != "" && !semver.IsValid(version) {
-		s.serveErrorPage(w, r, http.StatusBadRequest, &errorPage{
-			Message:          fmt.Sprintf("%q is not a valid semantic version.", version),
-			SecondaryMessage: suggestedSearch(path),
-		})
+		// The query is resolved against the versions of the module that
+		// contains path, which may differ from path itself.
+		modulePath := path
+		if latest, lerr := s.db.GetLatestPackage(ctx, path); lerr == nil {
+			modulePath = latest.VersionInfo.ModulePath
+		}
+		resolved, rerr := resolveVersionQuery(ctx, s.db, modulePath, version)
+		if rerr != nil {
+			log.Print(rerr)
+			secondary := suggestVersions(ctx, s.db, "/pkg", path)
+			if secondary == "" {
+				secondary = suggestedSearch(path)
+			}
+			s.serveErrorPage(w, r, http.StatusBadRequest, &errorPage{
+				Message:          fmt.Sprintf("%q is not a valid semantic version.", version),
+				SecondaryMessage: secondary,
+			})
+			return
+		}
+		redirectToVersion(w, r, "/pkg", path, resolved)
 		return
 	}
 
-	var (
-		pkg *internal.VersionedPackage
-		ctx = r.Context()
-	)
+	var pkg *internal.VersionedPackage
 	if version == "" {
 		pkg, err = s.db.GetLatestPackage(ctx, path)
 		if err != nil && !xerrors.Is(err, derrors.NotFound) {
@@ -174,20 +189,34 @@ func (s *Server) handleModuleDetails(w http.ResponseWriter, r *http.Request) {
 		s.serveErrorPage(w, r, http.StatusBadRequest, nil)
 		return
 	}
+	ctx := r.Context()
 	if !semver.IsValid(version) {
-		msg := fmt.Sprintf("%q is not a valid semantic version.", version)
-		if version == "" {
-			// TODO(b/138647480): Fall back to latest module version if version
-			// is not found.
-			msg = fmt.Sprintf("Version for %q must be specified.", path)
+		query := version
+		if query == "" {
+			// Fall back to the latest module version if none is supplied.
+			query = "latest"
+		}
+		resolved, rerr := resolveVersionQuery(ctx, s.db, path, query)
+		if rerr != nil {
+			log.Print(rerr)
+			msg := fmt.Sprintf("%q is not a valid semantic version.", version)
+			if version == "" {
+				msg = fmt.Sprintf("Version for %q must be specified.", path)
+			}
+			secondary := suggestVersions(ctx, s.db, "/mod", path)
+			if secondary == "" {
+				secondary = suggestedSearch(path)
+			}
+			s.serveErrorPage(w, r, http.StatusBadRequest, &errorPage{
+				Message:          msg,
+				SecondaryMessage: secondary,
+			})
+			return
 		}
-		s.serveErrorPage(w, r, http.StatusBadRequest, &errorPage{
-			Message: msg,
-		})
+		redirectToVersion(w, r, "/mod", path, resolved)
 		return
 	}
 
-	ctx := r.Context()
 	moduleVersion, err := s.db.GetVersionInfo(ctx, path, version)
 	if err != nil {
 		code := http.StatusNotFound
@@ -250,8 +279,19 @@ func fetchDetailsForModule(ctx context.Context, r *http.Request, tab string, db
 		return fetchModuleDetails(ctx, db, vi)
 	case "licenses":
 		return &LicensesDetails{Licenses: transformLicenses(licenses)}, nil
-	case "readme", "modfile", "versions", "dependents", "dependencies", "importedby":
-		// TODO(b/138448402): implement remaining module views.
+	case "readme":
+		return fetchReadMeDetails(ctx, db, vi)
+	case "modfile":
+		return fetchModFileDetails(ctx, db, vi)
+	case "versions":
+		return fetchModuleVersionsDetails(ctx, db, vi)
+	case "dependencies":
+		return fetchModuleDependenciesDetails(ctx, db, vi, newPaginationParams(r, 100))
+	case "dependents":
+		return fetchModuleDependentsDetails(ctx, db, vi, newPaginationParams(r, 100))
+	case "importedby":
+		// The "importedby" tab is not meaningful at the module level; it is
+		// shown per-package on the package details page instead.
 		return fetchReadMeDetails(ctx, db, vi)
 	}
 	return nil, fmt.Errorf("BUG: unable to fetch details: unknown tab %q", tab)
@@ -283,6 +323,155 @@ func parseModulePathAndVersion(urlPath string) (importPath, version string, err
 	return importPath, strings.TrimRight(parts[1], "/"), nil
 }
 
+// redirectToVersion redirects the client to the canonical URL for the given
+// namespace ("/pkg" or "/mod"), import path, and resolved semantic version,
+// preserving any query string (e.g. ?tab=) from the original request.
+func redirectToVersion(w http.ResponseWriter, r *http.Request, namespace, importPath, version string) {
+	u := fmt.Sprintf("%s/%s@%s", namespace, importPath, version)
+	if r.URL.RawQuery != "" {
+		u += "?" + r.URL.RawQuery
+	}
+	http.Redirect(w, r, u, http.StatusFound)
+}
+
+// resolveVersionQuery resolves the cmd/go-style version query for modulePath
+// (e.g. "latest", "v1", "v1.2.patch", ">v1.2.3") to a concrete semantic
+// version known to the database, preferring non-prereleases. If query
+// matches none of those forms, it is resolved as a VCS revision instead.
+func resolveVersionQuery(ctx context.Context, db *postgres.DB, modulePath, query string) (_ string, err error) {
+	defer derrors.Wrap(&err, "resolveVersionQuery(ctx, db, %q, %q)", modulePath, query)
+
+	versions, err := db.GetTaggedVersions(ctx, modulePath)
+	if err != nil {
+		return "", err
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return semver.Compare(versions[i], versions[j]) < 0
+	})
+
+	switch {
+	case query == "latest":
+		return closestVersion(versions, func(string) bool { return true }, false)
+	case strings.HasSuffix(query, ".patch"):
+		base := strings.TrimSuffix(query, ".patch")
+		return closestVersion(versions, versionPrefixMatcher(base), false)
+	case isVersionPrefix(query):
+		return closestVersion(versions, versionPrefixMatcher(query), false)
+	case strings.HasPrefix(query, ">="):
+		bound := query[len(">="):]
+		return closestVersion(versions, func(v string) bool { return semver.Compare(v, bound) >= 0 }, true)
+	case strings.HasPrefix(query, "<="):
+		bound := query[len("<="):]
+		return closestVersion(versions, func(v string) bool { return semver.Compare(v, bound) <= 0 }, false)
+	case strings.HasPrefix(query, ">"):
+		bound := query[len(">"):]
+		return closestVersion(versions, func(v string) bool { return semver.Compare(v, bound) > 0 }, true)
+	case strings.HasPrefix(query, "<"):
+		bound := query[len("<"):]
+		return closestVersion(versions, func(v string) bool { return semver.Compare(v, bound) < 0 }, false)
+	case semver.IsValid(query):
+		return closestVersion(versions, func(v string) bool { return v == query }, false)
+	}
+
+	// query isn't a recognized version query; treat it as an unresolved VCS
+	// revision (commit SHA, branch name, or tag) and resolve it to the
+	// pseudo-version or tagged semver it corresponds to.
+	resolved, err := db.GetVersionByRevision(ctx, modulePath, query)
+	if err != nil {
+		return "", fmt.Errorf("%w: revision %q not found", derrors.NotFound, query)
+	}
+	return resolved, nil
+}
+
+// suggestVersions returns a "did you mean one of these versions" message
+// linking to the most recent tagged versions of modulePath, or "" if none
+// are known.
+func suggestVersions(ctx context.Context, db *postgres.DB, namespace, modulePath string) template.HTML {
+	const maxSuggestions = 5
+
+	versions, err := db.GetTaggedVersions(ctx, modulePath)
+	if err != nil || len(versions) == 0 {
+		return ""
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return semver.Compare(versions[i], versions[j]) > 0
+	})
+	if len(versions) > maxSuggestions {
+		versions = versions[:maxSuggestions]
+	}
+
+	var links []string
+	for _, v := range versions {
+		links = append(links, fmt.Sprintf(`<a href="%s/%s@%s">%s</a>`, namespace, modulePath, v, v))
+	}
+	return template.HTML("Did you mean one of these versions? " + strings.Join(links, ", "))
+}
+
+// closestVersion returns the matching version closest to the query bound:
+// the lowest if wantLowest, otherwise the highest. versions must be sorted
+// ascending. A non-prerelease is always preferred over a prerelease.
+func closestVersion(versions []string, match func(string) bool, wantLowest bool) (string, error) {
+	var nonPrereleases, prereleases []string
+	for _, v := range versions {
+		if !match(v) {
+			continue
+		}
+		if semver.Prerelease(v) == "" {
+			nonPrereleases = append(nonPrereleases, v)
+		} else {
+			prereleases = append(prereleases, v)
+		}
+	}
+	pick := func(vs []string) (string, bool) {
+		if len(vs) == 0 {
+			return "", false
+		}
+		if wantLowest {
+			return vs[0], true
+		}
+		return vs[len(vs)-1], true
+	}
+	if v, ok := pick(nonPrereleases); ok {
+		return v, nil
+	}
+	if v, ok := pick(prereleases); ok {
+		return v, nil
+	}
+	return "", derrors.NotFound
+}
+
+// isVersionPrefix reports whether query is an incomplete semantic version
+// prefix, such as "v1" or "v1.2".
+func isVersionPrefix(query string) bool {
+	if len(query) < 2 || query[0] != 'v' {
+		return false
+	}
+	parts := strings.SplitN(query[1:], ".", 3)
+	if len(parts) > 2 {
+		return false
+	}
+	for _, p := range parts {
+		if p == "" {
+			return false
+		}
+		for _, c := range p {
+			if c < '0' || c > '9' {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// versionPrefixMatcher returns a predicate reporting whether a version's
+// major, or major.minor, prefix equals prefix.
+func versionPrefixMatcher(prefix string) func(string) bool {
+	if strings.Count(prefix, ".") == 0 {
+		return func(v string) bool { return semver.Major(v) == prefix }
+	}
+	return func(v string) bool { return semver.MajorMinor(v) == prefix }
+}
+
 // TabSettings defines tab-specific metadata.
 type TabSettings struct {
 	// Name is the tab name used in the URL.
@@ -360,7 +549,25 @@ var (
 			Name:              "versions",
 			AlwaysShowDetails: true,
 			DisplayName:       "Versions",
-			TemplateName:      "not_implemented.tmpl",
+			TemplateName:      "mod_versions.tmpl",
+		},
+		{
+			Name:              "modfile",
+			AlwaysShowDetails: true,
+			DisplayName:       "go.mod",
+			TemplateName:      "mod_modfile.tmpl",
+		},
+		{
+			Name:              "dependencies",
+			AlwaysShowDetails: true,
+			DisplayName:       "Dependencies",
+			TemplateName:      "mod_dependencies.tmpl",
+		},
+		{
+			Name:              "dependents",
+			AlwaysShowDetails: true,
+			DisplayName:       "Dependents",
+			TemplateName:      "mod_dependents.tmpl",
 		},
 		{
 			Name:         "licenses",
@@ -552,3 +759,193 @@ func fetchModuleDetails(ctx context.Context, db *postgres.DB, vi *internal.Versi
 		Packages:   packages,
 	}, nil
 }
+
+// ModuleVersionSeries holds the versions of a module sharing a single major
+// version, as reported by "go list -m -versions".
+type ModuleVersionSeries struct {
+	// Major is the major version prefix of the series, e.g. "v1". The series
+	// containing the overall latest version is labelled by its major version
+	// too.
+	Major string
+
+	// Latest is the highest version in the series.
+	Latest string
+
+	// Versions lists every known version in the series, highest first.
+	Versions []string
+}
+
+// ModuleVersionsDetails contains data for the module versions tab.
+type ModuleVersionsDetails struct {
+	ModulePath string
+	Series     []*ModuleVersionSeries
+}
+
+// fetchModuleVersionsDetails fetches the known tagged versions of the
+// module specified by vi and groups them into a ModuleVersionsDetails by
+// major version series, each marked with its latest version.
+func fetchModuleVersionsDetails(ctx context.Context, db *postgres.DB, vi *internal.VersionInfo) (_ *ModuleVersionsDetails, err error) {
+	defer derrors.Wrap(&err, "fetchModuleVersionsDetails(ctx, db, %q)", vi.ModulePath)
+
+	versions, err := db.GetTaggedVersions(ctx, vi.ModulePath)
+	if err != nil {
+		return nil, err
+	}
+	return &ModuleVersionsDetails{
+		ModulePath: vi.ModulePath,
+		Series:     groupVersionsByMajor(versions),
+	}, nil
+}
+
+// groupVersionsByMajor groups versions into series sharing a major version,
+// each marked with its latest version, in descending order of major version.
+func groupVersionsByMajor(versions []string) []*ModuleVersionSeries {
+	sorted := append([]string(nil), versions...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return semver.Compare(sorted[i], sorted[j]) > 0
+	})
+
+	var order []string
+	bySeries := make(map[string]*ModuleVersionSeries)
+	for _, v := range sorted {
+		major := semver.Major(v)
+		s, ok := bySeries[major]
+		if !ok {
+			s = &ModuleVersionSeries{Major: major, Latest: v}
+			bySeries[major] = s
+			order = append(order, major)
+		}
+		s.Versions = append(s.Versions, v)
+	}
+
+	series := make([]*ModuleVersionSeries, len(order))
+	for i, major := range order {
+		series[i] = bySeries[major]
+	}
+	return series
+}
+
+// ModFileDetails contains data for the module's go.mod file tab.
+type ModFileDetails struct {
+	ModulePath string
+	Version    string
+	Contents   template.HTML
+}
+
+// fetchModFileDetails fetches the go.mod file stored for the module version
+// specified by vi and returns a ModFileDetails with its require, replace,
+// and exclude blocks marked up for syntax highlighting.
+func fetchModFileDetails(ctx context.Context, db *postgres.DB, vi *internal.VersionInfo) (_ *ModFileDetails, err error) {
+	defer derrors.Wrap(&err, "fetchModFileDetails(ctx, db, %q, %q)", vi.ModulePath, vi.Version)
+
+	contents, err := db.GetModuleGoMod(ctx, vi.ModulePath, vi.Version)
+	if err != nil {
+		return nil, err
+	}
+	return &ModFileDetails{
+		ModulePath: vi.ModulePath,
+		Version:    vi.Version,
+		Contents:   highlightGoMod(contents),
+	}, nil
+}
+
+// goModDirectives are the go.mod directives that introduce a require,
+// replace, or exclude block or single-line statement.
+var goModDirectives = []string{"require", "replace", "exclude"}
+
+// highlightGoMod escapes contents and wraps each single-line require,
+// replace, or exclude statement, and each line inside a parenthesized
+// require, replace, or exclude block, in its own
+// <span class="go-mod-directive"> element for mod_modfile.tmpl to style.
+// The block's opening line and its closing ")" are not wrapped.
+func highlightGoMod(contents string) template.HTML {
+	var b strings.Builder
+	inBlock := false
+	for _, line := range strings.Split(contents, "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case inBlock && trimmed == ")":
+			inBlock = false
+			b.WriteString(template.HTMLEscapeString(line))
+		case inBlock:
+			fmt.Fprintf(&b, `<span class="go-mod-directive">%s</span>`, template.HTMLEscapeString(line))
+		case hasGoModDirective(trimmed, "("):
+			inBlock = true
+			b.WriteString(template.HTMLEscapeString(line))
+		case hasGoModDirective(trimmed, ""):
+			fmt.Fprintf(&b, `<span class="go-mod-directive">%s</span>`, template.HTMLEscapeString(line))
+		default:
+			b.WriteString(template.HTMLEscapeString(line))
+		}
+		b.WriteString("\n")
+	}
+	return template.HTML(b.String())
+}
+
+// hasGoModDirective reports whether trimmed is a go.mod line starting with
+// one of the require/replace/exclude directives, followed by a space and
+// then suffix (either "(" for a block opener, or "" for a single-line form).
+func hasGoModDirective(trimmed, suffix string) bool {
+	for _, d := range goModDirectives {
+		if strings.HasPrefix(trimmed, d+" "+suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// ModuleDependenciesDetails contains data for the module dependencies tab:
+// the modules imported by any package in the module, deduplicated by
+// module path.
+type ModuleDependenciesDetails struct {
+	ModulePath string
+	Version    string
+	Modules    []string
+	Pagination pagination
+}
+
+// fetchModuleDependenciesDetails aggregates the modules imported by the
+// packages of the module specified by vi, deduplicated by module path and
+// paginated according to pg.
+func fetchModuleDependenciesDetails(ctx context.Context, db *postgres.DB, vi *internal.VersionInfo, pg paginationParams) (_ *ModuleDependenciesDetails, err error) {
+	defer derrors.Wrap(&err, "fetchModuleDependenciesDetails(ctx, db, %q, %q)", vi.ModulePath, vi.Version)
+
+	modules, total, err := db.GetModuleDependencies(ctx, vi.ModulePath, vi.Version, pg.limit, pg.offset())
+	if err != nil {
+		return nil, err
+	}
+	return &ModuleDependenciesDetails{
+		ModulePath: vi.ModulePath,
+		Version:    vi.Version,
+		Modules:    modules,
+		Pagination: newPagination(pg, total),
+	}, nil
+}
+
+// ModuleDependentsDetails contains data for the module dependents tab: the
+// modules with a package that imports a package of the module, deduplicated
+// by module path.
+type ModuleDependentsDetails struct {
+	ModulePath string
+	Version    string
+	Modules    []string
+	Pagination pagination
+}
+
+// fetchModuleDependentsDetails aggregates the modules that depend on any
+// package of the module specified by vi, deduplicated by module path and
+// paginated according to pg.
+func fetchModuleDependentsDetails(ctx context.Context, db *postgres.DB, vi *internal.VersionInfo, pg paginationParams) (_ *ModuleDependentsDetails, err error) {
+	defer derrors.Wrap(&err, "fetchModuleDependentsDetails(ctx, db, %q, %q)", vi.ModulePath, vi.Version)
+
+	modules, total, err := db.GetModuleDependents(ctx, vi.ModulePath, pg.limit, pg.offset())
+	if err != nil {
+		return nil, err
+	}
+	return &ModuleDependentsDetails{
+		ModulePath: vi.ModulePath,
+		Version:    vi.Version,
+		Modules:    modules,
+		Pagination: newPagination(pg, total),
+	}, nil
+}
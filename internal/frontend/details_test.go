@@ -0,0 +1,133 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package frontend
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIsVersionPrefix(t *testing.T) {
+	tests := []struct {
+		query string
+		want  bool
+	}{
+		{"v1", true},
+		{"v1.2", true},
+		{"v1.2.3", false},
+		{"latest", false},
+		{"v1.2.patch", false},
+		{">v1.2.3", false},
+		{"v", false},
+		{"v1.", false},
+	}
+	for _, test := range tests {
+		if got := isVersionPrefix(test.query); got != test.want {
+			t.Errorf("isVersionPrefix(%q) = %t, want %t", test.query, got, test.want)
+		}
+	}
+}
+
+func TestVersionPrefixMatcher(t *testing.T) {
+	tests := []struct {
+		prefix, version string
+		want            bool
+	}{
+		{"v1", "v1.2.3", true},
+		{"v1", "v2.0.0", false},
+		{"v1.2", "v1.2.3", true},
+		{"v1.2", "v1.3.0", false},
+	}
+	for _, test := range tests {
+		if got := versionPrefixMatcher(test.prefix)(test.version); got != test.want {
+			t.Errorf("versionPrefixMatcher(%q)(%q) = %t, want %t", test.prefix, test.version, got, test.want)
+		}
+	}
+}
+
+func TestClosestVersion(t *testing.T) {
+	// versions must be supplied in ascending semver order, as resolveVersionQuery does.
+	versions := []string{"v1.0.0-alpha", "v1.0.0", "v1.1.0", "v1.2.0-beta", "v2.0.0"}
+	all := func(string) bool { return true }
+
+	tests := []struct {
+		name       string
+		versions   []string
+		match      func(string) bool
+		wantLowest bool
+		want       string
+		wantErr    bool
+	}{
+		{"highest prefers non-prerelease", versions, all, false, "v2.0.0", false},
+		{"lowest prefers non-prerelease", versions, all, true, "v1.0.0", false},
+		{"falls back to prerelease when no non-prerelease matches", versions,
+			func(v string) bool { return v == "v1.2.0-beta" }, false, "v1.2.0-beta", false},
+		{"no match returns NotFound", versions, func(string) bool { return false }, false, "", true},
+	}
+	for _, test := range tests {
+		got, err := closestVersion(test.versions, test.match, test.wantLowest)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("%s: closestVersion() = %q, want error", test.name, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: closestVersion() returned unexpected error: %v", test.name, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("%s: closestVersion() = %q, want %q", test.name, got, test.want)
+		}
+	}
+}
+
+func TestGroupVersionsByMajor(t *testing.T) {
+	versions := []string{"v1.0.0", "v2.1.0", "v1.2.0", "v2.0.0", "v0.1.0"}
+	want := []*ModuleVersionSeries{
+		{Major: "v2", Latest: "v2.1.0", Versions: []string{"v2.1.0", "v2.0.0"}},
+		{Major: "v1", Latest: "v1.2.0", Versions: []string{"v1.2.0", "v1.0.0"}},
+		{Major: "v0", Latest: "v0.1.0", Versions: []string{"v0.1.0"}},
+	}
+	got := groupVersionsByMajor(versions)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("groupVersionsByMajor(%v) = %+v, want %+v", versions, got, want)
+	}
+}
+
+func TestHighlightGoMod(t *testing.T) {
+	tests := []struct {
+		name, contents, want string
+	}{
+		{
+			"single-line directive",
+			"module example.com/foo\n\nrequire bar.com/baz v1.0.0\n",
+			"module example.com/foo\n\n" +
+				`<span class="go-mod-directive">require bar.com/baz v1.0.0</span>` + "\n\n",
+		},
+		{
+			"parenthesized block",
+			"require (\n\tbar.com/baz v1.0.0\n)\n",
+			"require (\n" +
+				`<span class="go-mod-directive">	bar.com/baz v1.0.0</span>` + "\n" +
+				")\n\n",
+		},
+		{
+			"commented-out directive is not highlighted",
+			"// require bar.com/baz v1.0.0\n",
+			"// require bar.com/baz v1.0.0\n\n",
+		},
+		{
+			"identifier prefixed with a directive name is not highlighted",
+			"requireSomething bar.com/baz v1.0.0\n",
+			"requireSomething bar.com/baz v1.0.0\n\n",
+		},
+	}
+	for _, test := range tests {
+		if got := string(highlightGoMod(test.contents)); got != test.want {
+			t.Errorf("%s: highlightGoMod(%q) = %q, want %q", test.name, test.contents, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package postgres
+
+import (
+	"context"
+
+	"golang.org/x/discovery/internal/derrors"
+)
+
+// GetModuleGoMod returns the contents of the go.mod file stored for the
+// module version specified by modulePath and version.
+func (db *DB) GetModuleGoMod(ctx context.Context, modulePath, version string) (contents string, err error) {
+	defer derrors.Wrap(&err, "GetModuleGoMod(ctx, %q, %q)", modulePath, version)
+
+	row := db.db.QueryRowContext(ctx, `
+		SELECT go_mod
+		FROM versions
+		WHERE module_path = $1 AND version = $2`,
+		modulePath, version)
+	if err := row.Scan(&contents); err != nil {
+		return "", err
+	}
+	return contents, nil
+}
+
+// GetModuleDependencies returns the module paths imported by any package in
+// the module version specified by modulePath and version, deduplicated by
+// module path and paginated by limit and offset. It also returns the total
+// number of distinct module paths, for use in rendering pagination.
+func (db *DB) GetModuleDependencies(ctx context.Context, modulePath, version string, limit, offset int) (modules []string, total int, err error) {
+	defer derrors.Wrap(&err, "GetModuleDependencies(ctx, %q, %q, %d, %d)", modulePath, version, limit, offset)
+
+	if err := db.db.QueryRowContext(ctx, `
+		SELECT COUNT(DISTINCT i.to_module_path)
+		FROM imports_unique i
+		WHERE i.from_module_path = $1 AND i.from_version = $2`,
+		modulePath, version).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT DISTINCT i.to_module_path
+		FROM imports_unique i
+		WHERE i.from_module_path = $1 AND i.from_version = $2
+		ORDER BY i.to_module_path
+		LIMIT $3 OFFSET $4`,
+		modulePath, version, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var m string
+		if err := rows.Scan(&m); err != nil {
+			return nil, 0, err
+		}
+		modules = append(modules, m)
+	}
+	return modules, total, rows.Err()
+}
+
+// GetModuleDependents returns the module paths with a package that imports
+// a package of modulePath, at any version, deduplicated by module path and
+// paginated by limit and offset. It also returns the total number of
+// distinct module paths, for use in rendering pagination.
+//
+// Dependents are not restricted to a single version of modulePath: like
+// pkg.go.dev's own "Imported By", whether a module depends on modulePath
+// doesn't depend on which of modulePath's versions is currently being
+// viewed.
+func (db *DB) GetModuleDependents(ctx context.Context, modulePath string, limit, offset int) (modules []string, total int, err error) {
+	defer derrors.Wrap(&err, "GetModuleDependents(ctx, %q, %d, %d)", modulePath, limit, offset)
+
+	if err := db.db.QueryRowContext(ctx, `
+		SELECT COUNT(DISTINCT i.from_module_path)
+		FROM imports_unique i
+		WHERE i.to_module_path = $1`,
+		modulePath).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT DISTINCT i.from_module_path
+		FROM imports_unique i
+		WHERE i.to_module_path = $1
+		ORDER BY i.from_module_path
+		LIMIT $2 OFFSET $3`,
+		modulePath, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var m string
+		if err := rows.Scan(&m); err != nil {
+			return nil, 0, err
+		}
+		modules = append(modules, m)
+	}
+	return modules, total, rows.Err()
+}
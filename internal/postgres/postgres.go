@@ -0,0 +1,20 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package postgres provides a means of interacting with the postgres
+// database that stores discovered modules, versions, and packages.
+package postgres
+
+import "database/sql"
+
+// DB wraps a sql.DB to provide an API for interacting with the discovery
+// database.
+type DB struct {
+	db *sql.DB
+}
+
+// New returns a new DB backed by db.
+func New(db *sql.DB) *DB {
+	return &DB{db: db}
+}
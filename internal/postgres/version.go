@@ -0,0 +1,77 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"golang.org/x/discovery/internal/derrors"
+)
+
+// GetTaggedVersions returns the known tagged (non-pseudo) versions of
+// modulePath, in no particular order.
+func (db *DB) GetTaggedVersions(ctx context.Context, modulePath string) (versions []string, err error) {
+	defer derrors.Wrap(&err, "GetTaggedVersions(ctx, %q)", modulePath)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT version
+		FROM versions
+		WHERE module_path = $1 AND version_type != 'pseudo'`,
+		modulePath)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var v string
+		if err := rows.Scan(&v); err != nil {
+			return nil, err
+		}
+		versions = append(versions, v)
+	}
+	return versions, rows.Err()
+}
+
+// GetVersionByRevision resolves rev, a VCS commit SHA (full or abbreviated),
+// branch name, or lightweight tag, to the pseudo-version or tagged semantic
+// version of modulePath that was fetched at that revision.
+func (db *DB) GetVersionByRevision(ctx context.Context, modulePath, rev string) (_ string, err error) {
+	defer derrors.Wrap(&err, "GetVersionByRevision(ctx, %q, %q)", modulePath, rev)
+
+	if rev == "" {
+		return "", derrors.NotFound
+	}
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT version, ref_name, commit_hash
+		FROM versions
+		WHERE module_path = $1
+		ORDER BY commit_time DESC`,
+		modulePath)
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var version, refName, commitHash string
+		if err := rows.Scan(&version, &refName, &commitHash); err != nil {
+			return "", err
+		}
+		// Match the full ref name (branch or tag), or rev as a prefix of the
+		// commit hash (a short or full SHA). Matching in Go, rather than via
+		// a SQL LIKE pattern built from rev, avoids rev's contents being
+		// interpreted as LIKE wildcards.
+		if refName == rev || strings.HasPrefix(commitHash, rev) {
+			return version, nil
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return "", err
+	}
+	return "", derrors.NotFound
+}